@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"contactmanagement/internal/auth"
+	"contactmanagement/internal/config"
+	"contactmanagement/internal/handlers"
+	"contactmanagement/internal/models"
+	"contactmanagement/internal/repository"
+	"contactmanagement/internal/types"
+)
+
+// App holds every subsystem the server depends on. It is built up once by
+// NewApp and stored on the Gin context by initRouter, so handlers and tests
+// can reach any subsystem (e.g. a mock repository) through it instead of
+// package-level globals.
+type App struct {
+	cfg    *config.Config
+	db     *gorm.DB
+	repo   repository.ContactRepository
+	users  repository.UserRepository
+	tags   repository.TagRepository
+	tokens *auth.TokenManager
+	logger *slog.Logger
+	router *gin.Engine
+
+	contactHandler *handlers.ContactHandler
+	authHandler    *handlers.AuthHandler
+	tagHandler     *handlers.TagHandler
+}
+
+// NewApp wires up a fully initialized App: database connection,
+// repositories, handlers, and router, in that order.
+func NewApp(cfg *config.Config) (*App, error) {
+	app := &App{
+		cfg:    cfg,
+		logger: slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+	}
+
+	models.SetDefaultPhoneRegion(cfg.Phone.DefaultRegion)
+
+	if err := app.initDB(); err != nil {
+		return nil, fmt.Errorf("init db: %w", err)
+	}
+	app.initRepo()
+	app.initHandlers()
+	app.initRouter()
+
+	return app, nil
+}
+
+// initDB connects to the database and runs the schema migration.
+func (a *App) initDB() error {
+	db, err := gorm.Open(postgres.Open(a.cfg.Database.GetDatabaseURL()), &gorm.Config{})
+	if err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+	if err := db.AutoMigrate(&types.User{}, &types.Contact{}, &types.Phone{}, &types.Tag{}); err != nil {
+		return fmt.Errorf("migrate: %w", err)
+	}
+	a.db = db
+	return nil
+}
+
+// initRepo builds the repositories and the token manager on top of a.db.
+func (a *App) initRepo() {
+	a.repo = repository.NewContactRepository(a.db)
+	a.users = repository.NewUserRepository(a.db)
+	a.tags = repository.NewTagRepository(a.db)
+	a.tokens = auth.NewTokenManager(a.cfg.Auth.JWTSecret, a.cfg.Auth.AccessTTL, a.cfg.Auth.RefreshTTL)
+}
+
+// initHandlers builds the HTTP handlers on top of a.repo/a.users/a.tags/a.tokens.
+func (a *App) initHandlers() {
+	a.contactHandler = handlers.NewContactHandler(a.repo)
+	a.authHandler = handlers.NewAuthHandler(a.users, a.tokens)
+	a.tagHandler = handlers.NewTagHandler(a.tags, a.repo)
+}
+
+// initRouter assembles the Gin engine: middleware, CORS, and routes.
+func (a *App) initRouter() {
+	gin.SetMode(a.cfg.Server.GinMode)
+
+	r := gin.Default()
+
+	// Make the App reachable from any handler via c.MustGet("app").
+	r.Use(func(c *gin.Context) {
+		c.Set("app", a)
+		c.Next()
+	})
+
+	corsConfig := cors.DefaultConfig()
+	corsConfig.AllowOrigins = a.cfg.CORS.AllowedOrigins
+	corsConfig.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
+	corsConfig.AllowHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization"}
+	r.Use(cors.New(corsConfig))
+
+	api := r.Group("/api")
+	{
+		authGroup := api.Group("/auth")
+		{
+			authGroup.POST("/register", a.authHandler.Register)
+			authGroup.POST("/login", a.authHandler.Login)
+			authGroup.POST("/refresh", a.authHandler.Refresh)
+		}
+
+		contacts := api.Group("/contacts")
+		contacts.Use(auth.Middleware(a.tokens))
+		{
+			contacts.POST("", a.contactHandler.CreateContact)
+			contacts.GET("", a.contactHandler.ListContacts)
+			contacts.GET("/:id", a.contactHandler.GetContact)
+			contacts.PUT("/:id", a.contactHandler.UpdateContact)
+			contacts.DELETE("/:id", a.contactHandler.DeleteContact)
+			contacts.POST("/import", a.contactHandler.ImportContacts)
+			contacts.GET("/export", a.contactHandler.ExportContacts)
+			contacts.POST("/:id/tags/:tagId", a.tagHandler.AttachTag)
+			contacts.DELETE("/:id/tags/:tagId", a.tagHandler.DetachTag)
+		}
+
+		tags := api.Group("/tags")
+		tags.Use(auth.Middleware(a.tokens))
+		{
+			tags.POST("", a.tagHandler.CreateTag)
+			tags.GET("", a.tagHandler.ListTags)
+			tags.DELETE("/:id", a.tagHandler.DeleteTag)
+		}
+	}
+
+	a.router = r
+}
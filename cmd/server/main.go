@@ -1,63 +1,20 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"log"
-
-	"github.com/gin-contrib/cors"
-	"github.com/gin-gonic/gin"
-	"gorm.io/driver/postgres"
-	"gorm.io/gorm"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"contactmanagement/internal/config"
-	"contactmanagement/internal/handlers"
-	"contactmanagement/internal/repository"
-	"contactmanagement/internal/types"
 )
 
-func initDB(cfg *config.Config) *gorm.DB {
-	db, err := gorm.Open(postgres.Open(cfg.Database.GetDatabaseURL()), &gorm.Config{})
-	if err != nil {
-		log.Fatal("Failed to connect to database:", err)
-	}
-
-	// Auto migrate the schema
-	err = db.AutoMigrate(&types.Contact{}, &types.Phone{})
-	if err != nil {
-		log.Fatal("Failed to migrate database:", err)
-	}
-
-	return db
-}
-
-func setupRouter(cfg *config.Config, contactHandler *handlers.ContactHandler) *gin.Engine {
-	// Set Gin mode
-	gin.SetMode(cfg.Server.GinMode)
-	
-	r := gin.Default()
-
-	// Configure CORS
-	corsConfig := cors.DefaultConfig()
-	corsConfig.AllowOrigins = cfg.CORS.AllowedOrigins
-	corsConfig.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
-	corsConfig.AllowHeaders = []string{"Origin", "Content-Type", "Accept"}
-	r.Use(cors.New(corsConfig))
-
-	// API routes
-	api := r.Group("/api")
-	{
-		contacts := api.Group("/contacts")
-		{
-			contacts.POST("", contactHandler.CreateContact)
-			contacts.GET("", contactHandler.ListContacts)
-			contacts.GET("/:id", contactHandler.GetContact)
-			contacts.PUT("/:id", contactHandler.UpdateContact)
-			contacts.DELETE("/:id", contactHandler.DeleteContact)
-			contacts.POST("/import", contactHandler.ImportContacts)
-		}
-	}
-
-	return r
-}
+// shutdownTimeout bounds how long we wait for in-flight requests to finish
+// once a shutdown signal is received.
+const shutdownTimeout = 10 * time.Second
 
 func main() {
 	// Load configuration
@@ -66,21 +23,34 @@ func main() {
 		log.Fatal("Failed to load configuration:", err)
 	}
 
-	// Initialize database
-	db := initDB(cfg)
+	// Build the application: db, repositories, handlers, router
+	app, err := NewApp(cfg)
+	if err != nil {
+		log.Fatal("Failed to initialize application:", err)
+	}
 
-	// Initialize repository
-	contactRepo := repository.NewContactRepository(db)
+	srv := &http.Server{
+		Addr:    ":" + cfg.Server.Port,
+		Handler: app.router,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
-	// Initialize handler with repository
-	contactHandler := handlers.NewContactHandler(contactRepo)
+	go func() {
+		app.logger.Info("server starting", "port", cfg.Server.Port)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal("Failed to start server:", err)
+		}
+	}()
 
-	// Setup router with handler
-	r := setupRouter(cfg, contactHandler)
-	
-	// Start server
-	log.Printf("Server starting on port %s", cfg.Server.Port)
-	if err := r.Run(":" + cfg.Server.Port); err != nil {
-		log.Fatal("Failed to start server:", err)
+	<-ctx.Done()
+	stop()
+	app.logger.Info("shutdown signal received, draining in-flight requests")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		app.logger.Error("graceful shutdown failed", "error", err)
 	}
-} 
\ No newline at end of file
+}
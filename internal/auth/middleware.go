@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// contextUserIDKey is the Gin context key Middleware stores the
+// authenticated user ID under.
+const contextUserIDKey = "userID"
+
+// Middleware extracts and verifies the bearer access token from the
+// Authorization header, putting the authenticated user ID on the request
+// context for handlers to read via UserID.
+func Middleware(tokens *TokenManager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		scheme, token, found := strings.Cut(header, " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing bearer token"})
+			return
+		}
+
+		claims, err := tokens.Verify(token, AccessTokenType)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Set(contextUserIDKey, claims.UserID)
+		c.Next()
+	}
+}
+
+// UserID returns the authenticated user ID set by Middleware.
+func UserID(c *gin.Context) (uint, bool) {
+	value, exists := c.Get(contextUserIDKey)
+	if !exists {
+		return 0, false
+	}
+	userID, ok := value.(uint)
+	return userID, ok
+}
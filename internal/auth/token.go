@@ -0,0 +1,76 @@
+// Package auth provides JWT-based authentication: token issuance and
+// verification, password hashing, and a Gin middleware that scopes requests
+// to the authenticated user.
+package auth
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Token types distinguish access tokens (short-lived, used on every request)
+// from refresh tokens (long-lived, only accepted by the refresh endpoint).
+const (
+	AccessTokenType  = "access"
+	RefreshTokenType = "refresh"
+)
+
+// Claims is the JWT claim set used for both access and refresh tokens.
+type Claims struct {
+	UserID uint   `json:"userId"`
+	Type   string `json:"type"`
+	jwt.RegisteredClaims
+}
+
+// TokenManager issues and verifies JWTs signed with a single shared secret.
+type TokenManager struct {
+	secret     []byte
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+// NewTokenManager creates a TokenManager for the given secret and token TTLs.
+func NewTokenManager(secret string, accessTTL, refreshTTL time.Duration) *TokenManager {
+	return &TokenManager{secret: []byte(secret), accessTTL: accessTTL, refreshTTL: refreshTTL}
+}
+
+// GenerateAccessToken issues a short-lived access token for userID.
+func (m *TokenManager) GenerateAccessToken(userID uint) (string, error) {
+	return m.generate(userID, AccessTokenType, m.accessTTL)
+}
+
+// GenerateRefreshToken issues a long-lived refresh token for userID.
+func (m *TokenManager) GenerateRefreshToken(userID uint) (string, error) {
+	return m.generate(userID, RefreshTokenType, m.refreshTTL)
+}
+
+func (m *TokenManager) generate(userID uint, tokenType string, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		UserID: userID,
+		Type:   tokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+}
+
+// Verify parses tokenString and checks that it is valid, unexpired, and of
+// the expected type (AccessTokenType or RefreshTokenType).
+func (m *TokenManager) Verify(tokenString, expectedType string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(*jwt.Token) (interface{}, error) {
+		return m.secret, nil
+	}, jwt.WithValidMethods([]string{"HS256"}))
+	if err != nil || !token.Valid {
+		return nil, errors.New("invalid or expired token")
+	}
+	if claims.Type != expectedType {
+		return nil, errors.New("unexpected token type")
+	}
+	return claims, nil
+}
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -12,6 +14,8 @@ type Config struct {
 	Server   ServerConfig
 	Database DatabaseConfig
 	CORS     CORSConfig
+	Phone    PhoneConfig
+	Auth     AuthConfig
 }
 
 // ServerConfig holds all server-related configuration
@@ -35,6 +39,20 @@ type CORSConfig struct {
 	AllowedOrigins []string
 }
 
+// PhoneConfig holds all phone-parsing-related configuration
+type PhoneConfig struct {
+	// DefaultRegion is the ISO 3166-1 alpha-2 region (e.g. "US") used to
+	// interpret phone numbers that don't include a country code.
+	DefaultRegion string
+}
+
+// AuthConfig holds all authentication-related configuration
+type AuthConfig struct {
+	JWTSecret  string
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+}
+
 // Load reads the environment variables and returns a Config struct
 func Load() (*Config, error) {
 	// Load .env file if it exists
@@ -58,6 +76,18 @@ func Load() (*Config, error) {
 		CORS: CORSConfig{
 			AllowedOrigins: []string{getEnvOrDefault("ALLOWED_ORIGINS", "http://localhost:3000")},
 		},
+		Phone: PhoneConfig{
+			DefaultRegion: getEnvOrDefault("DEFAULT_PHONE_REGION", "US"),
+		},
+		Auth: AuthConfig{
+			JWTSecret:  os.Getenv("JWT_SECRET"),
+			AccessTTL:  getDurationOrDefault("ACCESS_TOKEN_TTL", 15*time.Minute),
+			RefreshTTL: getDurationOrDefault("REFRESH_TOKEN_TTL", 7*24*time.Hour),
+		},
+	}
+
+	if config.Auth.JWTSecret == "" {
+		return nil, errors.New("JWT_SECRET environment variable must be set")
 	}
 
 	return config, nil
@@ -83,4 +113,13 @@ func getEnvOrDefault(key, defaultValue string) string {
 		return value
 	}
 	return defaultValue
+}
+
+func getDurationOrDefault(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if d, err := time.ParseDuration(value); err == nil {
+			return d
+		}
+	}
+	return defaultValue
 } 
\ No newline at end of file
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"contactmanagement/internal/auth"
+	"contactmanagement/internal/repository"
+	"contactmanagement/internal/types"
+)
+
+type AuthHandler struct {
+	users  repository.UserRepository
+	tokens *auth.TokenManager
+}
+
+func NewAuthHandler(users repository.UserRepository, tokens *auth.TokenManager) *AuthHandler {
+	return &AuthHandler{users: users, tokens: tokens}
+}
+
+func (h *AuthHandler) Register(c *gin.Context) {
+	var req types.RegisterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if existing, err := h.users.FindByEmail(c.Request.Context(), req.Email); err == nil && existing != nil {
+		c.JSON(http.StatusConflict, gin.H{"error": "Email already registered"})
+		return
+	}
+
+	passwordHash, err := auth.HashPassword(req.Password)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create account"})
+		return
+	}
+
+	user := &types.User{Email: req.Email, PasswordHash: passwordHash}
+	if err := h.users.Create(c.Request.Context(), user); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create account"})
+		return
+	}
+
+	h.respondWithTokens(c, http.StatusCreated, user.ID)
+}
+
+func (h *AuthHandler) Login(c *gin.Context) {
+	var req types.LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := h.users.FindByEmail(c.Request.Context(), req.Email)
+	if err != nil || !auth.CheckPassword(user.PasswordHash, req.Password) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid email or password"})
+		return
+	}
+
+	h.respondWithTokens(c, http.StatusOK, user.ID)
+}
+
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var req types.RefreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	claims, err := h.tokens.Verify(req.RefreshToken, auth.RefreshTokenType)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+		return
+	}
+
+	h.respondWithTokens(c, http.StatusOK, claims.UserID)
+}
+
+// respondWithTokens issues a fresh access/refresh token pair for userID.
+func (h *AuthHandler) respondWithTokens(c *gin.Context, status int, userID uint) {
+	accessToken, err := h.tokens.GenerateAccessToken(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to issue access token"})
+		return
+	}
+	refreshToken, err := h.tokens.GenerateRefreshToken(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to issue refresh token"})
+		return
+	}
+
+	c.JSON(status, types.AuthResponse{AccessToken: accessToken, RefreshToken: refreshToken})
+}
@@ -1,15 +1,15 @@
 package handlers
 
 import (
-	"bytes"
+	"fmt"
 	"io"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/gin-gonic/gin"
-	"github.com/gocarina/gocsv"
 
+	"contactmanagement/internal/auth"
+	"contactmanagement/internal/importer"
 	"contactmanagement/internal/models"
 	"contactmanagement/internal/repository"
 	"contactmanagement/internal/types"
@@ -23,7 +23,22 @@ func NewContactHandler(repo repository.ContactRepository) *ContactHandler {
 	return &ContactHandler{repo: repo}
 }
 
+// currentUserID reads the authenticated user ID set by auth.Middleware,
+// responding with 401 if it's somehow missing.
+func currentUserID(c *gin.Context) (uint, bool) {
+	userID, ok := auth.UserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+	}
+	return userID, ok
+}
+
 func (h *ContactHandler) CreateContact(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
 	var req types.CreateContactRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -49,14 +64,14 @@ func (h *ContactHandler) CreateContact(c *gin.Context) {
 	contact.FormatPhoneNumbers()
 
 	// Check for existing email using repository
-	existingContact, err := h.repo.FindByEmail(c.Request.Context(), req.Email)
+	existingContact, err := h.repo.FindByEmail(c.Request.Context(), userID, req.Email)
 	if err == nil && existingContact != nil {
 		c.JSON(http.StatusConflict, gin.H{"error": "Email already exists"})
 		return
 	}
 
 	// Create contact using repository
-	if err := h.repo.Create(c.Request.Context(), contact); err != nil {
+	if err := h.repo.Create(c.Request.Context(), userID, contact); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create contact"})
 		return
 	}
@@ -65,30 +80,77 @@ func (h *ContactHandler) CreateContact(c *gin.Context) {
 }
 
 func (h *ContactHandler) ListContacts(c *gin.Context) {
-	contacts, err := h.repo.List(c.Request.Context())
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+	pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	if err != nil || pageSize < 1 {
+		pageSize = 20
+	}
+
+	params := repository.SearchParams{
+		Query:     c.Query("q"),
+		FirstName: c.Query("firstName"),
+		LastName:  c.Query("lastName"),
+		Email:     c.Query("email"),
+		PhoneType: c.Query("phoneType"),
+		TagNames:  c.QueryArray("tag"),
+		SortBy:    c.Query("sort"),
+		SortOrder: c.Query("order"),
+		Page:      page,
+		PageSize:  pageSize,
+	}
+
+	contacts, total, err := h.repo.Search(c.Request.Context(), userID, params)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch contacts"})
 		return
 	}
 
-	c.JSON(http.StatusOK, contacts)
+	phoneFormat := c.Query("phoneFormat")
+	for i := range contacts {
+		contacts[i].FormatPhoneNumbersAs(phoneFormat)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"items": contacts,
+		"page":  page,
+		"total": total,
+	})
 }
 
 func (h *ContactHandler) GetContact(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
 		return
 	}
-	contact, err := h.repo.FindByID(c.Request.Context(), uint(id))
+	contact, err := h.repo.FindByID(c.Request.Context(), userID, uint(id))
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Contact not found"})
 		return
 	}
+	contact.FormatPhoneNumbersAs(c.Query("phoneFormat"))
 	c.JSON(http.StatusOK, contact)
 }
 
 func (h *ContactHandler) UpdateContact(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
@@ -102,7 +164,7 @@ func (h *ContactHandler) UpdateContact(c *gin.Context) {
 	}
 
 	// Get existing contact
-	existingContact, err := h.repo.FindByID(c.Request.Context(), uint(id))
+	existingContact, err := h.repo.FindByID(c.Request.Context(), userID, uint(id))
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Contact not found"})
 		return
@@ -125,14 +187,14 @@ func (h *ContactHandler) UpdateContact(c *gin.Context) {
 	existingContact.FormatPhoneNumbers()
 
 	// Check for duplicate email
-	emailContact, err := h.repo.FindByEmail(c.Request.Context(), req.Email)
+	emailContact, err := h.repo.FindByEmail(c.Request.Context(), userID, req.Email)
 	if err == nil && emailContact != nil && emailContact.Contact.ID != existingContact.Contact.ID {
 		c.JSON(http.StatusConflict, gin.H{"error": "Email already exists"})
 		return
 	}
 
 	// Update using repository
-	if err := h.repo.Update(c.Request.Context(), existingContact); err != nil {
+	if err := h.repo.Update(c.Request.Context(), userID, existingContact); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update contact"})
 		return
 	}
@@ -141,6 +203,11 @@ func (h *ContactHandler) UpdateContact(c *gin.Context) {
 }
 
 func (h *ContactHandler) DeleteContact(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
 	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
@@ -148,14 +215,14 @@ func (h *ContactHandler) DeleteContact(c *gin.Context) {
 	}
 
 	// Check if contact exists
-	_, err = h.repo.FindByID(c.Request.Context(), uint(id))
+	_, err = h.repo.FindByID(c.Request.Context(), userID, uint(id))
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Contact not found"})
 		return
 	}
 
 	// Delete using repository
-	if err := h.repo.Delete(c.Request.Context(), uint(id)); err != nil {
+	if err := h.repo.Delete(c.Request.Context(), userID, uint(id)); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete contact"})
 		return
 	}
@@ -164,65 +231,111 @@ func (h *ContactHandler) DeleteContact(c *gin.Context) {
 }
 
 func (h *ContactHandler) ImportContacts(c *gin.Context) {
-	file, err := c.FormFile("file")
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "No file uploaded"})
+	userID, ok := currentUserID(c)
+	if !ok {
 		return
 	}
 
-	if !strings.HasSuffix(file.Filename, ".csv") {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "File must be a CSV"})
+	format, reader, err := resolveImportFormat(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	defer reader.Close()
 
-	openedFile, err := file.Open()
+	parsedContacts, err := format.Parse(reader)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to open file"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to parse import file"})
 		return
 	}
-	defer openedFile.Close()
 
-	// Read the entire file into a buffer
-	fileBytes, err := io.ReadAll(openedFile)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read file"})
+	mode := c.DefaultQuery("mode", repository.ModeSkip)
+	if mode != repository.ModeStrict && mode != repository.ModeSkip && mode != repository.ModeUpsert {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "mode must be one of strict, skip, upsert"})
 		return
 	}
 
-	reader := bytes.NewReader(fileBytes)
-	var csvContacts []types.CSVContact
-	if err := gocsv.Unmarshal(reader, &csvContacts); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to parse CSV file"})
+	contactsToImport := make([]models.Contact, len(parsedContacts))
+	for i := range parsedContacts {
+		contactsToImport[i] = *models.NewContact(&parsedContacts[i])
+	}
+
+	// Validation, duplicate detection, and persistence all happen inside the
+	// repository so the whole batch runs in one transaction.
+	result, err := h.repo.ImportContacts(c.Request.Context(), userID, contactsToImport, mode)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Import aborted, no rows were saved", "result": result})
 		return
 	}
 
-	var contactsToImport []models.Contact
-	for _, csvContact := range csvContacts {
-		contact := models.NewContact(&types.Contact{
-			FirstName:    csvContact.FirstName,
-			LastName:     csvContact.LastName,
-			Email:        csvContact.Email,
-			PrimaryPhone: csvContact.PrimaryPhone,
-		})
+	c.JSON(http.StatusOK, result)
+}
 
-		// Validate each contact
-		if err := contact.Validate(); err != nil {
-			continue
+// resolveImportFormat picks the importer.Format for the request: a multipart
+// "file" upload is sniffed by filename, otherwise the raw request body is
+// dispatched by its Content-Type header.
+func resolveImportFormat(c *gin.Context) (importer.Format, io.ReadCloser, error) {
+	if file, err := c.FormFile("file"); err == nil {
+		format, err := importer.ForFilename(file.Filename)
+		if err != nil {
+			return nil, nil, err
 		}
+		opened, err := file.Open()
+		if err != nil {
+			return nil, nil, err
+		}
+		return format, opened, nil
+	}
 
-		// Format phone numbers
-		contact.FormatPhoneNumbers()
-		contactsToImport = append(contactsToImport, *contact)
+	format, err := importer.ForContentType(c.ContentType())
+	if err != nil {
+		return nil, nil, err
 	}
+	return format, c.Request.Body, nil
+}
 
-	// Import contacts using repository
-	if err := h.repo.ImportContacts(c.Request.Context(), contactsToImport); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to import contacts"})
+// ExportContacts streams every contact in the requested format
+// (?format=csv|json|vcard, defaulting to csv).
+func (h *ContactHandler) ExportContacts(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"message":  "Import completed",
-		"imported": len(contactsToImport),
-	})
+	formatName := c.DefaultQuery("format", importer.CSV)
+	format, err := importer.ForName(formatName)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	contacts, err := h.repo.List(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch contacts"})
+		return
+	}
+
+	plainContacts := make([]types.Contact, 0, len(contacts))
+	for _, contact := range contacts {
+		plainContacts = append(plainContacts, *contact.Contact)
+	}
+
+	contentType, filename := exportMetadata(formatName)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
+	c.Header("Content-Type", contentType)
+	if err := format.Write(c.Writer, plainContacts); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to export contacts"})
+		return
+	}
+}
+
+func exportMetadata(formatName string) (contentType, filename string) {
+	switch formatName {
+	case importer.JSON:
+		return "application/json", "contacts.json"
+	case importer.VCard:
+		return "text/vcard", "contacts.vcf"
+	default:
+		return "text/csv", "contacts.csv"
+	}
 }
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"contactmanagement/internal/repository"
+	"contactmanagement/internal/types"
+)
+
+type TagHandler struct {
+	tags     repository.TagRepository
+	contacts repository.ContactRepository
+}
+
+func NewTagHandler(tags repository.TagRepository, contacts repository.ContactRepository) *TagHandler {
+	return &TagHandler{tags: tags, contacts: contacts}
+}
+
+func (h *TagHandler) CreateTag(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req types.CreateTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	tag := &types.Tag{Name: req.Name, Color: req.Color}
+	if err := h.tags.Create(c.Request.Context(), userID, tag); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create tag"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, tag)
+}
+
+func (h *TagHandler) ListTags(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	tags, err := h.tags.List(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch tags"})
+		return
+	}
+
+	c.JSON(http.StatusOK, tags)
+}
+
+func (h *TagHandler) DeleteTag(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		return
+	}
+
+	if err := h.tags.Delete(c.Request.Context(), userID, uint(id)); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Tag not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Tag deleted successfully"})
+}
+
+// AttachTag handles POST /api/contacts/:id/tags/:tagId
+func (h *TagHandler) AttachTag(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	contactID, tagID, ok := parseContactTagIDs(c)
+	if !ok {
+		return
+	}
+
+	if err := h.contacts.AttachTag(c.Request.Context(), userID, contactID, tagID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to attach tag"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Tag attached successfully"})
+}
+
+// DetachTag handles DELETE /api/contacts/:id/tags/:tagId
+func (h *TagHandler) DetachTag(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	contactID, tagID, ok := parseContactTagIDs(c)
+	if !ok {
+		return
+	}
+
+	if err := h.contacts.DetachTag(c.Request.Context(), userID, contactID, tagID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to detach tag"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Tag detached successfully"})
+}
+
+func parseContactTagIDs(c *gin.Context) (contactID, tagID uint, ok bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid contact ID format"})
+		return 0, 0, false
+	}
+	tid, err := strconv.ParseUint(c.Param("tagId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tag ID format"})
+		return 0, 0, false
+	}
+	return uint(id), uint(tid), true
+}
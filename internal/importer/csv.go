@@ -0,0 +1,44 @@
+package importer
+
+import (
+	"io"
+
+	"github.com/gocarina/gocsv"
+
+	"contactmanagement/internal/types"
+)
+
+// CSVFormat reads and writes contacts using the types.CSVContact column
+// layout ("First Name", "Last Name", "Email Address", "Primary Phone Number").
+type CSVFormat struct{}
+
+func (CSVFormat) Parse(r io.Reader) ([]types.Contact, error) {
+	var rows []types.CSVContact
+	if err := gocsv.Unmarshal(r, &rows); err != nil {
+		return nil, err
+	}
+
+	contacts := make([]types.Contact, 0, len(rows))
+	for _, row := range rows {
+		contacts = append(contacts, types.Contact{
+			FirstName:    row.FirstName,
+			LastName:     row.LastName,
+			Email:        row.Email,
+			PrimaryPhone: row.PrimaryPhone,
+		})
+	}
+	return contacts, nil
+}
+
+func (CSVFormat) Write(w io.Writer, contacts []types.Contact) error {
+	rows := make([]types.CSVContact, 0, len(contacts))
+	for _, contact := range contacts {
+		rows = append(rows, types.CSVContact{
+			FirstName:    contact.FirstName,
+			LastName:     contact.LastName,
+			Email:        contact.Email,
+			PrimaryPhone: contact.PrimaryPhone,
+		})
+	}
+	return gocsv.Marshal(rows, w)
+}
@@ -0,0 +1,65 @@
+// Package importer converts between the application's Contact type and
+// external contact interchange formats (CSV, JSON, vCard).
+package importer
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"contactmanagement/internal/types"
+)
+
+// Format name identifiers, shared between import dispatch, export dispatch,
+// and filename sniffing.
+const (
+	CSV   = "csv"
+	JSON  = "json"
+	VCard = "vcard"
+)
+
+// Format parses and serializes contacts in a particular interchange format.
+type Format interface {
+	Parse(r io.Reader) ([]types.Contact, error)
+	Write(w io.Writer, contacts []types.Contact) error
+}
+
+// ForName returns the Format registered under name (one of CSV, JSON, VCard).
+func ForName(name string) (Format, error) {
+	switch name {
+	case CSV:
+		return &CSVFormat{}, nil
+	case JSON:
+		return &JSONFormat{}, nil
+	case VCard:
+		return &VCardFormat{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", name)
+	}
+}
+
+// ForContentType maps an HTTP Content-Type header to a Format.
+func ForContentType(contentType string) (Format, error) {
+	switch {
+	case strings.Contains(contentType, "csv"):
+		return ForName(CSV)
+	case strings.Contains(contentType, "json"):
+		return ForName(JSON)
+	case strings.Contains(contentType, "vcard"):
+		return ForName(VCard)
+	default:
+		return nil, fmt.Errorf("unsupported content type %q", contentType)
+	}
+}
+
+// ForFilename guesses a Format from a file's extension, defaulting to CSV.
+func ForFilename(filename string) (Format, error) {
+	switch {
+	case strings.HasSuffix(filename, ".json"):
+		return ForName(JSON)
+	case strings.HasSuffix(filename, ".vcf"), strings.HasSuffix(filename, ".vcard"):
+		return ForName(VCard)
+	default:
+		return ForName(CSV)
+	}
+}
@@ -0,0 +1,122 @@
+package importer
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+
+	"contactmanagement/internal/types"
+)
+
+// TestJSONFormat_RoundTrip asserts that writing a contact and parsing it back
+// reproduces the user-supplied fields, and never round-trips ID, UserID, or
+// the gorm.Model timestamps (re-importing an export must not collide with
+// the row it came from).
+func TestJSONFormat_RoundTrip(t *testing.T) {
+	exported := []types.Contact{{
+		Model:        gorm.Model{ID: 42, CreatedAt: time.Unix(0, 0)},
+		UserID:       7,
+		FirstName:    "Alice",
+		LastName:     "Anderson",
+		Email:        "alice@example.com",
+		PrimaryPhone: "+14155550001",
+		AdditionalPhones: []types.Phone{
+			{Number: "+14155550002", Type: "work"},
+		},
+	}}
+
+	var buf bytes.Buffer
+	if err := (JSONFormat{}).Write(&buf, exported); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	parsed, err := (JSONFormat{}).Parse(&buf)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(parsed) != 1 {
+		t.Fatalf("parsed %d contacts, want 1", len(parsed))
+	}
+
+	got := parsed[0]
+	if got.ID != 0 || got.UserID != 0 || !got.CreatedAt.IsZero() {
+		t.Fatalf("round-tripped contact carries ID/UserID/CreatedAt: %+v", got)
+	}
+	if got.FirstName != "Alice" || got.Email != "alice@example.com" || got.PrimaryPhone != "+14155550001" {
+		t.Fatalf("round-tripped contact lost a field: %+v", got)
+	}
+	if len(got.AdditionalPhones) != 1 || got.AdditionalPhones[0].Number != "+14155550002" {
+		t.Fatalf("additional phones not round-tripped: %+v", got.AdditionalPhones)
+	}
+}
+
+// TestCSVFormat_RoundTrip asserts the CSV format preserves the columns it
+// defines and never surfaces a primary key.
+func TestCSVFormat_RoundTrip(t *testing.T) {
+	exported := []types.Contact{{
+		Model:        gorm.Model{ID: 1},
+		FirstName:    "Bob",
+		LastName:     "Brown",
+		Email:        "bob@example.com",
+		PrimaryPhone: "+14155550003",
+	}}
+
+	var buf bytes.Buffer
+	if err := (CSVFormat{}).Write(&buf, exported); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	parsed, err := (CSVFormat{}).Parse(&buf)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(parsed) != 1 {
+		t.Fatalf("parsed %d contacts, want 1", len(parsed))
+	}
+	if parsed[0].ID != 0 {
+		t.Fatalf("round-tripped contact carries an ID: %+v", parsed[0])
+	}
+	if parsed[0].FirstName != "Bob" || parsed[0].Email != "bob@example.com" {
+		t.Fatalf("round-tripped contact lost a field: %+v", parsed[0])
+	}
+}
+
+// TestVCardFormat_RoundTrip asserts the vCard format preserves name, email,
+// primary phone, and additional phones with their types.
+func TestVCardFormat_RoundTrip(t *testing.T) {
+	exported := []types.Contact{{
+		FirstName:    "Carol",
+		LastName:     "Clark",
+		Email:        "carol@example.com",
+		PrimaryPhone: "+14155550004",
+		AdditionalPhones: []types.Phone{
+			{Number: "+14155550005", Type: "home"},
+		},
+	}}
+
+	var buf bytes.Buffer
+	if err := (VCardFormat{}).Write(&buf, exported); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	parsed, err := (VCardFormat{}).Parse(&buf)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(parsed) != 1 {
+		t.Fatalf("parsed %d contacts, want 1", len(parsed))
+	}
+
+	got := parsed[0]
+	if got.FirstName != "Carol" || got.LastName != "Clark" {
+		t.Fatalf("name not round-tripped: %+v", got)
+	}
+	if got.Email != "carol@example.com" || got.PrimaryPhone != "+14155550004" {
+		t.Fatalf("email/primary phone not round-tripped: %+v", got)
+	}
+	if len(got.AdditionalPhones) != 1 || got.AdditionalPhones[0].Number != "+14155550005" || got.AdditionalPhones[0].Type != "home" {
+		t.Fatalf("additional phones not round-tripped: %+v", got.AdditionalPhones)
+	}
+}
@@ -0,0 +1,57 @@
+package importer
+
+import (
+	"encoding/json"
+	"io"
+
+	"contactmanagement/internal/types"
+)
+
+// JSONFormat reads and writes contacts as a JSON array of types.JSONContact,
+// which carries only the safe, user-supplied fields (never ID, UserID, or
+// the gorm.Model timestamps).
+type JSONFormat struct{}
+
+func (JSONFormat) Parse(r io.Reader) ([]types.Contact, error) {
+	var rows []types.JSONContact
+	if err := json.NewDecoder(r).Decode(&rows); err != nil {
+		return nil, err
+	}
+
+	contacts := make([]types.Contact, 0, len(rows))
+	for _, row := range rows {
+		phones := make([]types.Phone, 0, len(row.AdditionalPhones))
+		for _, phone := range row.AdditionalPhones {
+			phones = append(phones, types.Phone{Number: phone.Number, Type: phone.Type})
+		}
+		contacts = append(contacts, types.Contact{
+			FirstName:        row.FirstName,
+			LastName:         row.LastName,
+			Email:            row.Email,
+			PrimaryPhone:     row.PrimaryPhone,
+			AdditionalPhones: phones,
+		})
+	}
+	return contacts, nil
+}
+
+func (JSONFormat) Write(w io.Writer, contacts []types.Contact) error {
+	rows := make([]types.JSONContact, 0, len(contacts))
+	for _, contact := range contacts {
+		phones := make([]types.JSONPhone, 0, len(contact.AdditionalPhones))
+		for _, phone := range contact.AdditionalPhones {
+			phones = append(phones, types.JSONPhone{Number: phone.Number, Type: phone.Type})
+		}
+		rows = append(rows, types.JSONContact{
+			FirstName:        contact.FirstName,
+			LastName:         contact.LastName,
+			Email:            contact.Email,
+			PrimaryPhone:     contact.PrimaryPhone,
+			AdditionalPhones: phones,
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(rows)
+}
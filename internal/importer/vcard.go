@@ -0,0 +1,152 @@
+package importer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"contactmanagement/internal/types"
+)
+
+// VCardFormat reads and writes contacts as vCard 4.0 (RFC 6350), mapping the
+// FN, N, EMAIL, and TEL;TYPE= properties to types.Contact/types.Phone.
+type VCardFormat struct{}
+
+func (VCardFormat) Parse(r io.Reader) ([]types.Contact, error) {
+	lines, err := unfoldLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var contacts []types.Contact
+	var current *types.Contact
+
+	for _, line := range lines {
+		switch {
+		case strings.EqualFold(line, "BEGIN:VCARD"):
+			current = &types.Contact{}
+		case strings.EqualFold(line, "END:VCARD"):
+			if current != nil {
+				contacts = append(contacts, *current)
+				current = nil
+			}
+		case current == nil:
+			continue
+		default:
+			applyProperty(current, line)
+		}
+	}
+
+	return contacts, nil
+}
+
+func applyProperty(contact *types.Contact, line string) {
+	name, params, value := splitProperty(line)
+	switch name {
+	case "FN":
+		if contact.FirstName == "" && contact.LastName == "" {
+			parts := strings.SplitN(value, " ", 2)
+			contact.FirstName = parts[0]
+			if len(parts) > 1 {
+				contact.LastName = parts[1]
+			}
+		}
+	case "N":
+		parts := strings.Split(value, ";")
+		if len(parts) > 0 {
+			contact.LastName = parts[0]
+		}
+		if len(parts) > 1 {
+			contact.FirstName = parts[1]
+		}
+	case "EMAIL":
+		contact.Email = value
+	case "TEL":
+		phoneType := phoneTypeFromParams(params)
+		if contact.PrimaryPhone == "" {
+			contact.PrimaryPhone = value
+		} else {
+			contact.AdditionalPhones = append(contact.AdditionalPhones, types.Phone{
+				Number: value,
+				Type:   phoneType,
+			})
+		}
+	}
+}
+
+func (VCardFormat) Write(w io.Writer, contacts []types.Contact) error {
+	for _, contact := range contacts {
+		fmt.Fprint(w, "BEGIN:VCARD\r\n")
+		fmt.Fprint(w, "VERSION:4.0\r\n")
+		fmt.Fprintf(w, "N:%s;%s;;;\r\n", contact.LastName, contact.FirstName)
+		fmt.Fprintf(w, "FN:%s %s\r\n", contact.FirstName, contact.LastName)
+		if contact.Email != "" {
+			fmt.Fprintf(w, "EMAIL:%s\r\n", contact.Email)
+		}
+		if contact.PrimaryPhone != "" {
+			fmt.Fprintf(w, "TEL;TYPE=primary:%s\r\n", contact.PrimaryPhone)
+		}
+		for _, phone := range contact.AdditionalPhones {
+			phoneType := phone.Type
+			if phoneType == "" {
+				phoneType = "other"
+			}
+			fmt.Fprintf(w, "TEL;TYPE=%s:%s\r\n", phoneType, phone.Number)
+		}
+		fmt.Fprint(w, "END:VCARD\r\n")
+	}
+	return nil
+}
+
+// unfoldLines reads a vCard stream and joins folded lines: per RFC 6350 §3.2,
+// a CRLF followed by a space or tab continues the previous content line.
+func unfoldLines(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// splitProperty splits a vCard content line of the form
+// "NAME;PARAM=VALUE;...:value" into its name, parameters, and value.
+func splitProperty(line string) (name string, params map[string]string, value string) {
+	params = make(map[string]string)
+	colon := strings.Index(line, ":")
+	if colon == -1 {
+		return strings.ToUpper(line), params, ""
+	}
+
+	head := line[:colon]
+	value = line[colon+1:]
+
+	segments := strings.Split(head, ";")
+	name = strings.ToUpper(segments[0])
+	for _, segment := range segments[1:] {
+		kv := strings.SplitN(segment, "=", 2)
+		if len(kv) == 2 {
+			params[strings.ToUpper(kv[0])] = kv[1]
+		}
+	}
+	return name, params, value
+}
+
+func phoneTypeFromParams(params map[string]string) string {
+	if t, ok := params["TYPE"]; ok {
+		return strings.ToLower(t)
+	}
+	return "other"
+}
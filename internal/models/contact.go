@@ -3,11 +3,27 @@ package models
 import (
 	"errors"
 	"regexp"
+	"strconv"
 	"strings"
 
+	"github.com/nyaruka/phonenumbers"
+
 	"contactmanagement/internal/types"
 )
 
+// defaultPhoneRegion is the ISO 3166-1 alpha-2 region used to interpret
+// phone numbers that don't include a country code. It is set once at
+// startup from config.PhoneConfig.DefaultRegion via SetDefaultPhoneRegion.
+var defaultPhoneRegion = "US"
+
+// SetDefaultPhoneRegion configures the region used to parse phone numbers
+// that don't include a country code (e.g. "415-555-1212").
+func SetDefaultPhoneRegion(region string) {
+	if region != "" {
+		defaultPhoneRegion = strings.ToUpper(region)
+	}
+}
+
 // Contact represents a contact with business logic
 type Contact struct {
 	*types.Contact
@@ -32,10 +48,17 @@ func (c *Contact) Validate() error {
 	if !isValidPhone(c.PrimaryPhone) {
 		return errors.New("invalid primary phone format")
 	}
-	// Prevent duplicate phone numbers (number + type)
+	for _, phone := range c.AdditionalPhones {
+		if !isValidPhone(phone.Number) {
+			return errors.New("invalid additional phone format")
+		}
+	}
+	// Prevent duplicate phone numbers (number + type). Numbers are normalized
+	// to E.164 first so the same number written in different formats (e.g.
+	// "+1 (415) 555-1212" vs "415-555-1212") is still recognized as a dup.
 	phoneSet := make(map[string]struct{})
 	for _, phone := range c.AdditionalPhones {
-		key := phone.Number + "-" + phone.Type
+		key := normalizedPhoneKey(phone.Number) + "-" + phone.Type
 		if _, exists := phoneSet[key]; exists {
 			return errors.New("duplicate phone numbers are not allowed")
 		}
@@ -44,11 +67,26 @@ func (c *Contact) Validate() error {
 	return nil
 }
 
-// FormatPhoneNumbers formats all phone numbers in the contact
+// FormatPhoneNumbers normalizes every phone number on the contact to its
+// canonical E.164 form and populates the derived CountryCode/NationalNumber
+// fields. Numbers that fail to parse are left untouched; Validate should
+// always be called first to reject those.
 func (c *Contact) FormatPhoneNumbers() {
-	c.PrimaryPhone = formatPhoneNumber(c.PrimaryPhone)
+	normalizePhone(&c.PrimaryPhone, &c.CountryCode, &c.NationalNumber)
+	for i := range c.AdditionalPhones {
+		phone := &c.AdditionalPhones[i]
+		normalizePhone(&phone.Number, &phone.CountryCode, &phone.NationalNumber)
+	}
+}
+
+// FormatPhoneNumbersAs rewrites every phone number on the contact into the
+// requested display format ("e164", "international", or "national") without
+// touching CountryCode/NationalNumber. It is meant to be applied to a
+// contact already loaded from the database, just before it's serialized.
+func (c *Contact) FormatPhoneNumbersAs(format string) {
+	displayPhone(&c.PrimaryPhone, format)
 	for i := range c.AdditionalPhones {
-		c.AdditionalPhones[i].Number = formatPhoneNumber(c.AdditionalPhones[i].Number)
+		displayPhone(&c.AdditionalPhones[i].Number, format)
 	}
 }
 
@@ -60,15 +98,53 @@ func isValidEmail(email string) bool {
 }
 
 func isValidPhone(phone string) bool {
-	// Remove all non-numeric characters
-	cleaned := regexp.MustCompile(`\D`).ReplaceAllString(phone, "")
-	// Check if the resulting number is 10-15 digits
-	return len(cleaned) >= 10 && len(cleaned) <= 15
+	parsed, err := phonenumbers.Parse(phone, defaultPhoneRegion)
+	if err != nil {
+		return false
+	}
+	return phonenumbers.IsValidNumber(parsed)
+}
+
+// normalizedPhoneKey parses number in the default region and returns its
+// canonical E.164 form for use as a dedup key, falling back to the raw
+// number unchanged if it fails to parse.
+func normalizedPhoneKey(number string) string {
+	parsed, err := phonenumbers.Parse(number, defaultPhoneRegion)
+	if err != nil {
+		return number
+	}
+	return phonenumbers.Format(parsed, phonenumbers.E164)
 }
 
-func formatPhoneNumber(phone string) string {
-	// Remove all non-numeric characters
-	cleaned := regexp.MustCompile(`\D`).ReplaceAllString(phone, "")
-	// Format can be customized based on your needs
-	return cleaned
+// normalizePhone parses number in the default region and, if valid,
+// rewrites number to its canonical E.164 form and fills in countryCode and
+// nationalNumber. It leaves all three untouched on a parse failure.
+func normalizePhone(number, countryCode, nationalNumber *string) {
+	parsed, err := phonenumbers.Parse(*number, defaultPhoneRegion)
+	if err != nil || !phonenumbers.IsValidNumber(parsed) {
+		return
+	}
+	*number = phonenumbers.Format(parsed, phonenumbers.E164)
+	*countryCode = strconv.Itoa(int(parsed.GetCountryCode()))
+	*nationalNumber = strconv.FormatUint(parsed.GetNationalNumber(), 10)
+}
+
+// displayPhone reformats an already-canonical E.164 number for display.
+func displayPhone(number *string, format string) {
+	parsed, err := phonenumbers.Parse(*number, defaultPhoneRegion)
+	if err != nil {
+		return
+	}
+	*number = phonenumbers.Format(parsed, phoneNumberFormat(format))
+}
+
+func phoneNumberFormat(format string) phonenumbers.PhoneNumberFormat {
+	switch format {
+	case "international":
+		return phonenumbers.INTERNATIONAL
+	case "national":
+		return phonenumbers.NATIONAL
+	default:
+		return phonenumbers.E164
+	}
 }
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"testing"
+
+	"contactmanagement/internal/types"
+)
+
+// TestFormatPhoneNumbers_NormalizesToE164 asserts that FormatPhoneNumbers
+// rewrites a phone number in any recognizable format to its canonical E.164
+// form and fills in the derived country code/national number.
+func TestFormatPhoneNumbers_NormalizesToE164(t *testing.T) {
+	contact := NewContact(&types.Contact{
+		FirstName:    "Alice",
+		LastName:     "Anderson",
+		Email:        "alice@example.com",
+		PrimaryPhone: "(415) 555-1212",
+	})
+
+	contact.FormatPhoneNumbers()
+
+	if contact.PrimaryPhone != "+14155551212" {
+		t.Fatalf("PrimaryPhone = %q, want +14155551212", contact.PrimaryPhone)
+	}
+	if contact.CountryCode != "1" {
+		t.Fatalf("CountryCode = %q, want 1", contact.CountryCode)
+	}
+	if contact.NationalNumber != "4155551212" {
+		t.Fatalf("NationalNumber = %q, want 4155551212", contact.NationalNumber)
+	}
+}
+
+// TestValidate_CatchesDuplicateAdditionalPhonesAcrossFormats asserts that two
+// additional phones on the same contact, written in different formats, are
+// still recognized as the same number.
+func TestValidate_CatchesDuplicateAdditionalPhonesAcrossFormats(t *testing.T) {
+	contact := NewContact(&types.Contact{
+		FirstName:    "Alice",
+		LastName:     "Anderson",
+		Email:        "alice@example.com",
+		PrimaryPhone: "+14155550000",
+		AdditionalPhones: []types.Phone{
+			{Number: "+1 (415) 555-1212", Type: "home"},
+			{Number: "415-555-1212", Type: "home"},
+		},
+	})
+
+	if err := contact.Validate(); err == nil {
+		t.Fatal("Validate: want duplicate phone error, got nil")
+	}
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"contactmanagement/internal/models"
+	"contactmanagement/internal/types"
+)
+
+func importRows(rows ...types.Contact) []models.Contact {
+	contacts := make([]models.Contact, len(rows))
+	for i := range rows {
+		contacts[i] = *models.NewContact(&rows[i])
+	}
+	return contacts
+}
+
+// TestImportContacts_ModeSkip asserts that valid rows are persisted and
+// invalid/duplicate rows are reported without aborting the batch.
+func TestImportContacts_ModeSkip(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repo := NewContactRepository(db)
+	const userID uint = 1
+
+	rows := importRows(
+		types.Contact{FirstName: "Alice", LastName: "Anderson", Email: "alice@example.com", PrimaryPhone: "+14155550001"},
+		types.Contact{FirstName: "Bad", LastName: "Row", Email: "not-an-email", PrimaryPhone: "+14155550002"},
+		types.Contact{FirstName: "Alice2", LastName: "Anderson", Email: "alice@example.com", PrimaryPhone: "+14155550003"},
+	)
+
+	result, err := repo.ImportContacts(ctx, userID, rows, ModeSkip)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if result.Imported != 1 {
+		t.Fatalf("Imported = %d, want 1", result.Imported)
+	}
+	if result.Failed != 2 {
+		t.Fatalf("Failed = %d, want 2", result.Failed)
+	}
+
+	contacts, err := repo.List(ctx, userID)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(contacts) != 1 {
+		t.Fatalf("persisted contacts = %d, want 1", len(contacts))
+	}
+}
+
+// TestImportContacts_ModeStrict asserts that a single failing row rolls back
+// the entire batch and the reported Imported count reflects that nothing was
+// actually saved.
+func TestImportContacts_ModeStrict(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repo := NewContactRepository(db)
+	const userID uint = 1
+
+	rows := importRows(
+		types.Contact{FirstName: "Alice", LastName: "Anderson", Email: "alice@example.com", PrimaryPhone: "+14155550001"},
+		types.Contact{FirstName: "Bad", LastName: "Row", Email: "not-an-email", PrimaryPhone: "+14155550002"},
+	)
+
+	result, err := repo.ImportContacts(ctx, userID, rows, ModeStrict)
+	if err == nil {
+		t.Fatal("import: want error on strict-mode failure, got nil")
+	}
+	if result.Imported != 0 {
+		t.Fatalf("Imported = %d, want 0 after rollback", result.Imported)
+	}
+
+	contacts, err := repo.List(ctx, userID)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(contacts) != 0 {
+		t.Fatalf("persisted contacts = %d, want 0 (transaction should have rolled back)", len(contacts))
+	}
+}
+
+// TestImportContacts_ModeUpsert asserts that a row whose email matches an
+// existing contact updates it in place instead of failing as a duplicate.
+func TestImportContacts_ModeUpsert(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repo := NewContactRepository(db)
+	const userID uint = 1
+
+	existing := models.NewContact(&types.Contact{
+		FirstName: "Alice", LastName: "Anderson", Email: "alice@example.com", PrimaryPhone: "+14155550001",
+	})
+	if err := repo.Create(ctx, userID, existing); err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+
+	rows := importRows(
+		types.Contact{FirstName: "Alice", LastName: "Updated", Email: "alice@example.com", PrimaryPhone: "+14155550001"},
+	)
+
+	result, err := repo.ImportContacts(ctx, userID, rows, ModeUpsert)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if result.Imported != 1 || result.Failed != 0 {
+		t.Fatalf("result = %+v, want 1 imported, 0 failed", result)
+	}
+
+	updated, err := repo.FindByID(ctx, userID, existing.ID)
+	if err != nil {
+		t.Fatalf("find: %v", err)
+	}
+	if updated.LastName != "Updated" {
+		t.Fatalf("LastName = %q, want %q (upsert should have updated the existing row)", updated.LastName, "Updated")
+	}
+
+	contacts, err := repo.List(ctx, userID)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(contacts) != 1 {
+		t.Fatalf("persisted contacts = %d, want 1 (upsert shouldn't create a second row)", len(contacts))
+	}
+}
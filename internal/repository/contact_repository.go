@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 
 	"contactmanagement/internal/models"
 	"contactmanagement/internal/types"
@@ -10,16 +12,48 @@ import (
 	"gorm.io/gorm"
 )
 
-// ContactRepository defines the interface for contact persistence operations
+// SearchParams describes the filtering, sorting, and pagination options
+// accepted by ContactRepository.Search.
+type SearchParams struct {
+	Query     string // free-text search across name, email, and phone numbers
+	FirstName string
+	LastName  string
+	Email     string
+	PhoneType string
+	TagNames  []string // AND semantics: a contact must carry every one of these tags
+	SortBy    string   // firstName, lastName, email, createdAt (defaults to id)
+	SortOrder string   // asc or desc (defaults to asc)
+	Page      int
+	PageSize  int
+}
+
+// maxPageSize caps how many contacts Search will return in a single page,
+// regardless of what the caller requests.
+const maxPageSize = 100
+
+// Import modes accepted by ContactRepository.ImportContacts.
+const (
+	ModeStrict = "strict" // abort and roll back the whole import on the first error
+	ModeSkip   = "skip"   // import valid rows, report the rest as failed
+	ModeUpsert = "upsert" // like skip, but update the existing contact on email match
+)
+
+// ContactRepository defines the interface for contact persistence operations.
+// Every method is scoped to the owning user: callers always pass the
+// authenticated userID, and no contact outside that tenant is ever visible.
 type ContactRepository interface {
-	Create(ctx context.Context, contact *models.Contact) error
-	FindByID(ctx context.Context, id uint) (*models.Contact, error)
-	Update(ctx context.Context, contact *models.Contact) error
-	Delete(ctx context.Context, id uint) error
-	List(ctx context.Context) ([]models.Contact, error)
-	FindByEmail(ctx context.Context, email string) (*models.Contact, error)
-	ImportContacts(ctx context.Context, contacts []models.Contact) error
-	CheckDuplicatePhone(ctx context.Context, phoneNumber string, excludeContactID uint) (bool, error)
+	Create(ctx context.Context, userID uint, contact *models.Contact) error
+	FindByID(ctx context.Context, userID uint, id uint) (*models.Contact, error)
+	Update(ctx context.Context, userID uint, contact *models.Contact) error
+	Upsert(ctx context.Context, userID uint, contact *models.Contact) error
+	Delete(ctx context.Context, userID uint, id uint) error
+	List(ctx context.Context, userID uint) ([]models.Contact, error)
+	Search(ctx context.Context, userID uint, params SearchParams) ([]models.Contact, int64, error)
+	FindByEmail(ctx context.Context, userID uint, email string) (*models.Contact, error)
+	ImportContacts(ctx context.Context, userID uint, contacts []models.Contact, mode string) (*types.ImportResult, error)
+	CheckDuplicatePhone(ctx context.Context, userID uint, phoneNumber string, excludeContactID uint) (bool, error)
+	AttachTag(ctx context.Context, userID uint, contactID uint, tagID uint) error
+	DetachTag(ctx context.Context, userID uint, contactID uint, tagID uint) error
 }
 
 // GormContactRepository implements ContactRepository using GORM
@@ -32,11 +66,11 @@ func NewContactRepository(db *gorm.DB) ContactRepository {
 	return &GormContactRepository{db: db}
 }
 
-// CheckDuplicatePhone checks if a phone number exists in any contact
-func (r *GormContactRepository) CheckDuplicatePhone(ctx context.Context, phoneNumber string, excludeContactID uint) (bool, error) {
+// CheckDuplicatePhone checks if a phone number exists on any of this user's contacts
+func (r *GormContactRepository) CheckDuplicatePhone(ctx context.Context, userID uint, phoneNumber string, excludeContactID uint) (bool, error) {
 	var count int64
 	query := r.db.WithContext(ctx).Model(&types.Contact{}).
-		Where("primary_phone = ?", phoneNumber)
+		Where("user_id = ? AND primary_phone = ?", userID, phoneNumber)
 
 	if excludeContactID > 0 {
 		query = query.Where("id != ?", excludeContactID)
@@ -49,12 +83,13 @@ func (r *GormContactRepository) CheckDuplicatePhone(ctx context.Context, phoneNu
 		return true, nil
 	}
 
-	// Check in additional phones
+	// Check in additional phones, joining back to contacts to stay within the tenant
 	query = r.db.WithContext(ctx).Model(&types.Phone{}).
-		Where("number = ?", phoneNumber)
+		Joins("JOIN contacts ON contacts.id = phones.contact_id").
+		Where("contacts.user_id = ? AND phones.number = ?", userID, phoneNumber)
 
 	if excludeContactID > 0 {
-		query = query.Where("contact_id != ?", excludeContactID)
+		query = query.Where("phones.contact_id != ?", excludeContactID)
 	}
 
 	if err := query.Count(&count).Error; err != nil {
@@ -63,10 +98,10 @@ func (r *GormContactRepository) CheckDuplicatePhone(ctx context.Context, phoneNu
 	return count > 0, nil
 }
 
-// Create stores a new contact and its additional phones
-func (r *GormContactRepository) Create(ctx context.Context, contact *models.Contact) error {
+// Create stores a new contact and its additional phones under the given user
+func (r *GormContactRepository) Create(ctx context.Context, userID uint, contact *models.Contact) error {
 	// Check for duplicate primary phone
-	exists, err := r.CheckDuplicatePhone(ctx, contact.PrimaryPhone, 0)
+	exists, err := r.CheckDuplicatePhone(ctx, userID, contact.PrimaryPhone, 0)
 	if err != nil {
 		return err
 	}
@@ -76,7 +111,7 @@ func (r *GormContactRepository) Create(ctx context.Context, contact *models.Cont
 
 	// Check for duplicate additional phones
 	for _, phone := range contact.AdditionalPhones {
-		exists, err := r.CheckDuplicatePhone(ctx, phone.Number, 0)
+		exists, err := r.CheckDuplicatePhone(ctx, userID, phone.Number, 0)
 		if err != nil {
 			return err
 		}
@@ -86,6 +121,7 @@ func (r *GormContactRepository) Create(ctx context.Context, contact *models.Cont
 	}
 
 	// Save the contact
+	contact.UserID = userID
 	if err := r.db.WithContext(ctx).Create(contact.Contact).Error; err != nil {
 		return err
 	}
@@ -104,19 +140,28 @@ func (r *GormContactRepository) Create(ctx context.Context, contact *models.Cont
 	return nil
 }
 
-// FindByID retrieves a contact by ID
-func (r *GormContactRepository) FindByID(ctx context.Context, id uint) (*models.Contact, error) {
+// FindByID retrieves one of this user's contacts by ID
+func (r *GormContactRepository) FindByID(ctx context.Context, userID uint, id uint) (*models.Contact, error) {
 	var contact models.Contact
-	if err := r.db.WithContext(ctx).Preload("AdditionalPhones").First(&contact, id).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("AdditionalPhones").Preload("Tags").
+		Where("user_id = ?", userID).First(&contact, id).Error; err != nil {
 		return nil, err
 	}
 	return &contact, nil
 }
 
-// Update modifies an existing contact and its additional phones
-func (r *GormContactRepository) Update(ctx context.Context, contact *models.Contact) error {
+// Update modifies an existing contact and its additional phones. It fails
+// with gorm.ErrRecordNotFound if the contact doesn't exist or belongs to a
+// different user: gorm.(*DB).Save updates by primary key and ignores a
+// chained Where clause that matches no row, so ownership must be confirmed
+// up front rather than left to the query itself.
+func (r *GormContactRepository) Update(ctx context.Context, userID uint, contact *models.Contact) error {
+	if _, err := r.FindByID(ctx, userID, contact.ID); err != nil {
+		return err
+	}
+
 	// Check for duplicate primary phone
-	exists, err := r.CheckDuplicatePhone(ctx, contact.PrimaryPhone, contact.ID)
+	exists, err := r.CheckDuplicatePhone(ctx, userID, contact.PrimaryPhone, contact.ID)
 	if err != nil {
 		return err
 	}
@@ -126,7 +171,7 @@ func (r *GormContactRepository) Update(ctx context.Context, contact *models.Cont
 
 	// Check for duplicate additional phones
 	for _, phone := range contact.AdditionalPhones {
-		exists, err := r.CheckDuplicatePhone(ctx, phone.Number, contact.ID)
+		exists, err := r.CheckDuplicatePhone(ctx, userID, phone.Number, contact.ID)
 		if err != nil {
 			return err
 		}
@@ -136,6 +181,7 @@ func (r *GormContactRepository) Update(ctx context.Context, contact *models.Cont
 	}
 
 	// Update the contact
+	contact.UserID = userID
 	if err := r.db.WithContext(ctx).Save(contact.Contact).Error; err != nil {
 		return err
 	}
@@ -159,35 +205,279 @@ func (r *GormContactRepository) Update(ctx context.Context, contact *models.Cont
 	return nil
 }
 
-// Delete removes a contact and its additional phones permanently from the database
-func (r *GormContactRepository) Delete(ctx context.Context, id uint) error {
-	// First delete all associated phone numbers (hard delete)
-	if err := r.db.WithContext(ctx).Unscoped().Where("contact_id = ?", id).Delete(&types.Phone{}).Error; err != nil {
+// Upsert creates a new contact, or updates the existing contact matched by
+// email (within the same tenant) if one already exists.
+func (r *GormContactRepository) Upsert(ctx context.Context, userID uint, contact *models.Contact) error {
+	existing, err := r.FindByEmail(ctx, userID, contact.Email)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		return r.Create(ctx, userID, contact)
+	}
+
+	contact.ID = existing.ID
+	return r.Update(ctx, userID, contact)
+}
+
+// Delete removes one of this user's contacts and its additional phones
+// permanently from the database
+func (r *GormContactRepository) Delete(ctx context.Context, userID uint, id uint) error {
+	// First delete all associated phone numbers (hard delete), scoped to the owning user
+	if err := r.db.WithContext(ctx).Unscoped().
+		Where("contact_id IN (?)", r.db.Model(&types.Contact{}).Select("id").Where("id = ? AND user_id = ?", id, userID)).
+		Delete(&types.Phone{}).Error; err != nil {
 		return err
 	}
 	// Then delete the contact (hard delete)
-	return r.db.WithContext(ctx).Unscoped().Delete(&types.Contact{}, id).Error
+	return r.db.WithContext(ctx).Unscoped().Where("user_id = ?", userID).Delete(&types.Contact{}, id).Error
 }
 
-// List retrieves all contacts
-func (r *GormContactRepository) List(ctx context.Context) ([]models.Contact, error) {
+// List retrieves all contacts owned by the given user
+func (r *GormContactRepository) List(ctx context.Context, userID uint) ([]models.Contact, error) {
 	var contacts []models.Contact
-	if err := r.db.WithContext(ctx).Preload("AdditionalPhones").Find(&contacts).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("AdditionalPhones").Preload("Tags").
+		Where("user_id = ?", userID).Find(&contacts).Error; err != nil {
 		return nil, err
 	}
 	return contacts, nil
 }
 
-// FindByEmail finds a contact by email address
-func (r *GormContactRepository) FindByEmail(ctx context.Context, email string) (*models.Contact, error) {
+// AttachTag associates one of this user's tags with one of this user's
+// contacts. It is a no-op if the association already exists.
+func (r *GormContactRepository) AttachTag(ctx context.Context, userID uint, contactID uint, tagID uint) error {
+	contact, err := r.FindByID(ctx, userID, contactID)
+	if err != nil {
+		return err
+	}
+
+	var tag types.Tag
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&tag, tagID).Error; err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Model(contact.Contact).Association("Tags").Append(&tag)
+}
+
+// DetachTag removes the association between one of this user's tags and one
+// of this user's contacts, leaving both records themselves intact.
+func (r *GormContactRepository) DetachTag(ctx context.Context, userID uint, contactID uint, tagID uint) error {
+	contact, err := r.FindByID(ctx, userID, contactID)
+	if err != nil {
+		return err
+	}
+
+	var tag types.Tag
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&tag, tagID).Error; err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Model(contact.Contact).Association("Tags").Delete(&tag)
+}
+
+// Search retrieves a page of the given user's contacts matching the given
+// filters, along with the total number of matching contacts (ignoring pagination).
+func (r *GormContactRepository) Search(ctx context.Context, userID uint, params SearchParams) ([]models.Contact, int64, error) {
+	query := r.db.WithContext(ctx).Model(&types.Contact{}).
+		Joins("LEFT JOIN phones ON phones.contact_id = contacts.id AND phones.deleted_at IS NULL").
+		Where("contacts.user_id = ?", userID).
+		Group("contacts.id")
+
+	if params.Query != "" {
+		like := "%" + params.Query + "%"
+		query = query.Where(
+			"contacts.first_name ILIKE ? OR contacts.last_name ILIKE ? OR contacts.email ILIKE ? OR contacts.primary_phone ILIKE ? OR phones.number ILIKE ?",
+			like, like, like, like, like,
+		)
+	}
+	if params.FirstName != "" {
+		query = query.Where("contacts.first_name ILIKE ?", "%"+params.FirstName+"%")
+	}
+	if params.LastName != "" {
+		query = query.Where("contacts.last_name ILIKE ?", "%"+params.LastName+"%")
+	}
+	if params.Email != "" {
+		query = query.Where("contacts.email ILIKE ?", "%"+params.Email+"%")
+	}
+	if params.PhoneType != "" {
+		query = query.Where("phones.type = ?", params.PhoneType)
+	}
+	if len(params.TagNames) > 0 {
+		query = query.
+			Joins("JOIN contact_tags ON contact_tags.contact_id = contacts.id").
+			Joins("JOIN tags ON tags.id = contact_tags.tag_id AND tags.deleted_at IS NULL AND tags.name IN ?", params.TagNames).
+			Having("COUNT(DISTINCT tags.name) = ?", len(params.TagNames))
+	}
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Distinct("contacts.id").Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	page := params.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := params.PageSize
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	order := fmt.Sprintf("contacts.%s %s", sortColumnFor(params.SortBy), sortOrderFor(params.SortOrder))
+
+	var contacts []models.Contact
+	err := query.Order(order).
+		Limit(pageSize).
+		Offset((page - 1) * pageSize).
+		Preload("AdditionalPhones").
+		Preload("Tags").
+		Find(&contacts).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return contacts, total, nil
+}
+
+// sortColumnFor maps an API sort key to a known, safe column name.
+func sortColumnFor(sortBy string) string {
+	switch sortBy {
+	case "firstName":
+		return "first_name"
+	case "lastName":
+		return "last_name"
+	case "email":
+		return "email"
+	case "createdAt":
+		return "created_at"
+	default:
+		return "id"
+	}
+}
+
+// sortOrderFor normalizes a requested sort order to ASC or DESC.
+func sortOrderFor(sortOrder string) string {
+	if strings.EqualFold(sortOrder, "desc") {
+		return "DESC"
+	}
+	return "ASC"
+}
+
+// FindByEmail finds one of this user's contacts by email address
+func (r *GormContactRepository) FindByEmail(ctx context.Context, userID uint, email string) (*models.Contact, error) {
 	var contact models.Contact
-	if err := r.db.WithContext(ctx).Preload("AdditionalPhones").Where("email = ?", email).First(&contact).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("AdditionalPhones").
+		Where("user_id = ? AND email = ?", userID, email).First(&contact).Error; err != nil {
 		return nil, err
 	}
 	return &contact, nil
 }
 
-// ImportContacts imports multiple contacts
-func (r *GormContactRepository) ImportContacts(ctx context.Context, contacts []models.Contact) error {
-	return r.db.WithContext(ctx).Create(contacts).Error
+// ImportContacts validates and persists a batch of contacts under the given
+// user inside a single transaction, reporting per-row failures instead of
+// aborting outright.
+//
+// mode controls what happens to invalid or conflicting rows:
+//   - ModeStrict rolls back the entire import on the first error.
+//   - ModeSkip persists the valid rows and reports the rest as failed.
+//   - ModeUpsert behaves like ModeSkip, but updates the existing contact
+//     when a row's email matches one already in the database.
+func (r *GormContactRepository) ImportContacts(ctx context.Context, userID uint, contacts []models.Contact, mode string) (*types.ImportResult, error) {
+	result := &types.ImportResult{}
+	seenEmails := make(map[string]struct{}, len(contacts))
+	seenPhones := make(map[string]struct{}, len(contacts))
+
+	txErr := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepo := &GormContactRepository{db: tx}
+
+		for i := range contacts {
+			row := i + 1
+			contact := &contacts[i]
+
+			if field, message := validateImportRow(contact, seenEmails, seenPhones); message != "" {
+				result.Failed++
+				result.Errors = append(result.Errors, types.ImportRowError{Row: row, Field: field, Message: message})
+				if mode == ModeStrict {
+					return errors.New(message)
+				}
+				continue
+			}
+			seenEmails[contact.Email] = struct{}{}
+			for _, number := range allPhoneNumbers(contact) {
+				seenPhones[number] = struct{}{}
+			}
+
+			if mode == ModeUpsert {
+				if err := txRepo.Upsert(ctx, userID, contact); err != nil {
+					result.Failed++
+					result.Errors = append(result.Errors, types.ImportRowError{Row: row, Field: "phone", Message: err.Error()})
+					continue
+				}
+				result.Imported++
+				continue
+			}
+
+			if existing, err := txRepo.FindByEmail(ctx, userID, contact.Email); err == nil && existing != nil {
+				result.Failed++
+				result.Errors = append(result.Errors, types.ImportRowError{Row: row, Field: "email", Message: "email already exists"})
+				if mode == ModeStrict {
+					return errors.New("email already exists")
+				}
+				continue
+			}
+
+			if err := txRepo.Create(ctx, userID, contact); err != nil {
+				result.Failed++
+				result.Errors = append(result.Errors, types.ImportRowError{Row: row, Field: "phone", Message: err.Error()})
+				if mode == ModeStrict {
+					return err
+				}
+				continue
+			}
+			result.Imported++
+		}
+
+		return nil
+	})
+	if txErr != nil {
+		// The whole transaction was rolled back, so nothing counted toward
+		// result.Imported actually made it to the database.
+		result.Imported = 0
+		return result, txErr
+	}
+
+	return result, nil
+}
+
+// validateImportRow runs business validation on a row and checks it against
+// the contacts already seen earlier in the same import batch. It returns an
+// empty message when the row is valid.
+func validateImportRow(contact *models.Contact, seenEmails, seenPhones map[string]struct{}) (field, message string) {
+	if err := contact.Validate(); err != nil {
+		return "validation", err.Error()
+	}
+	contact.FormatPhoneNumbers()
+
+	if _, exists := seenEmails[contact.Email]; exists {
+		return "email", "duplicate email within import batch"
+	}
+	for _, number := range allPhoneNumbers(contact) {
+		if _, exists := seenPhones[number]; exists {
+			return "phone", "duplicate phone number within import batch"
+		}
+	}
+
+	return "", ""
+}
+
+func allPhoneNumbers(contact *models.Contact) []string {
+	numbers := make([]string, 0, len(contact.AdditionalPhones)+1)
+	numbers = append(numbers, contact.PrimaryPhone)
+	for _, phone := range contact.AdditionalPhones {
+		numbers = append(numbers, phone.Number)
+	}
+	return numbers
 }
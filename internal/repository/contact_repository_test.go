@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"contactmanagement/internal/models"
+	"contactmanagement/internal/types"
+)
+
+// newTestDB returns an in-memory SQLite database migrated with the schema
+// the repository operates on.
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	if err := db.AutoMigrate(&types.User{}, &types.Contact{}, &types.Phone{}, &types.Tag{}); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return db
+}
+
+// TestContactRepository_CrossTenantIsolation asserts that a contact created
+// under one user is completely invisible to another user: FindByID must not
+// return it, and Update/Delete must not touch it.
+func TestContactRepository_CrossTenantIsolation(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repo := NewContactRepository(db)
+
+	const userA uint = 1
+	const userB uint = 2
+
+	contact := models.NewContact(&types.Contact{
+		FirstName:    "Alice",
+		LastName:     "Anderson",
+		Email:        "alice@example.com",
+		PrimaryPhone: "+14155551212",
+	})
+	if err := repo.Create(ctx, userA, contact); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	if _, err := repo.FindByID(ctx, userB, contact.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("FindByID as other user: got err %v, want gorm.ErrRecordNotFound", err)
+	}
+
+	update := models.NewContact(&types.Contact{
+		FirstName:    "Mallory",
+		LastName:     "Attacker",
+		Email:        "alice@example.com",
+		PrimaryPhone: "+14155551212",
+	})
+	update.ID = contact.ID
+	if err := repo.Update(ctx, userB, update); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("update as other user: got err %v, want gorm.ErrRecordNotFound", err)
+	}
+	unchanged, err := repo.FindByID(ctx, userA, contact.ID)
+	if err != nil {
+		t.Fatalf("re-fetch as owner: %v", err)
+	}
+	if unchanged.FirstName != "Alice" {
+		t.Fatalf("contact was modified by a non-owning user: first name is now %q", unchanged.FirstName)
+	}
+
+	if err := repo.Delete(ctx, userB, contact.ID); err != nil {
+		t.Fatalf("delete as other user: %v", err)
+	}
+	if _, err := repo.FindByID(ctx, userA, contact.ID); err != nil {
+		t.Fatalf("contact was deleted by a non-owning user: %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"contactmanagement/internal/models"
+	"contactmanagement/internal/types"
+)
+
+// seedContact creates and returns a minimal valid contact for userID.
+func seedContact(t *testing.T, ctx context.Context, repo ContactRepository, userID uint, firstName, email, phone string) *models.Contact {
+	t.Helper()
+	contact := models.NewContact(&types.Contact{
+		FirstName:    firstName,
+		LastName:     "Doe",
+		Email:        email,
+		PrimaryPhone: phone,
+	})
+	if err := repo.Create(ctx, userID, contact); err != nil {
+		t.Fatalf("seed contact %q: %v", firstName, err)
+	}
+	return contact
+}
+
+// TestSearch_Pagination asserts that Search returns the correct page of
+// results along with the total count across all pages, and that an oversized
+// PageSize is accepted and still returns every matching row.
+func TestSearch_Pagination(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repo := NewContactRepository(db)
+	const userID uint = 1
+
+	for i := 0; i < 5; i++ {
+		seedContact(t, ctx, repo, userID, "Contact", fmt.Sprintf("contact%d@example.com", i), fmt.Sprintf("+141555512%02d", i))
+	}
+
+	page1, total, err := repo.Search(ctx, userID, SearchParams{Page: 1, PageSize: 2})
+	if err != nil {
+		t.Fatalf("search page 1: %v", err)
+	}
+	if total != 5 {
+		t.Fatalf("total = %d, want 5", total)
+	}
+	if len(page1) != 2 {
+		t.Fatalf("page 1 len = %d, want 2", len(page1))
+	}
+
+	page3, _, err := repo.Search(ctx, userID, SearchParams{Page: 3, PageSize: 2})
+	if err != nil {
+		t.Fatalf("search page 3: %v", err)
+	}
+	if len(page3) != 1 {
+		t.Fatalf("page 3 len = %d, want 1 (5 contacts, page size 2)", len(page3))
+	}
+
+	uncapped, _, err := repo.Search(ctx, userID, SearchParams{Page: 1, PageSize: 1000000})
+	if err != nil {
+		t.Fatalf("search uncapped page size: %v", err)
+	}
+	if len(uncapped) != 5 {
+		t.Fatalf("uncapped page len = %d, want all 5 (PageSize should be clamped, not fetch extra rows)", len(uncapped))
+	}
+}
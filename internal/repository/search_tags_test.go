@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"contactmanagement/internal/types"
+)
+
+// TestSearch_TagFilterIsAND asserts that filtering by multiple tags only
+// returns contacts that carry every one of them, not any one of them.
+func TestSearch_TagFilterIsAND(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	repo := NewContactRepository(db)
+	tagRepo := NewTagRepository(db)
+	const userID uint = 1
+
+	work := &types.Tag{Name: "work"}
+	vip := &types.Tag{Name: "vip"}
+	if err := tagRepo.Create(ctx, userID, work); err != nil {
+		t.Fatalf("create tag work: %v", err)
+	}
+	if err := tagRepo.Create(ctx, userID, vip); err != nil {
+		t.Fatalf("create tag vip: %v", err)
+	}
+
+	both := seedContact(t, ctx, repo, userID, "Both", "both@example.com", "+14155550001")
+	onlyWork := seedContact(t, ctx, repo, userID, "OnlyWork", "onlywork@example.com", "+14155550002")
+
+	if err := repo.AttachTag(ctx, userID, both.ID, work.ID); err != nil {
+		t.Fatalf("attach work to both: %v", err)
+	}
+	if err := repo.AttachTag(ctx, userID, both.ID, vip.ID); err != nil {
+		t.Fatalf("attach vip to both: %v", err)
+	}
+	if err := repo.AttachTag(ctx, userID, onlyWork.ID, work.ID); err != nil {
+		t.Fatalf("attach work to onlyWork: %v", err)
+	}
+
+	results, total, err := repo.Search(ctx, userID, SearchParams{TagNames: []string{"work", "vip"}})
+	if err != nil {
+		t.Fatalf("search by tags: %v", err)
+	}
+	if total != 1 || len(results) != 1 {
+		t.Fatalf("got %d result(s), want exactly 1 (the contact with both tags)", len(results))
+	}
+	if results[0].ID != both.ID {
+		t.Fatalf("got contact %d, want %d", results[0].ID, both.ID)
+	}
+}
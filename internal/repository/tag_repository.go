@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+
+	"contactmanagement/internal/types"
+
+	"gorm.io/gorm"
+)
+
+// TagRepository defines the interface for tag persistence operations. Every
+// method is scoped to the owning user, matching ContactRepository.
+type TagRepository interface {
+	Create(ctx context.Context, userID uint, tag *types.Tag) error
+	List(ctx context.Context, userID uint) ([]types.Tag, error)
+	FindByID(ctx context.Context, userID uint, id uint) (*types.Tag, error)
+	Delete(ctx context.Context, userID uint, id uint) error
+}
+
+// GormTagRepository implements TagRepository using GORM
+type GormTagRepository struct {
+	db *gorm.DB
+}
+
+// NewTagRepository creates a new tag repository
+func NewTagRepository(db *gorm.DB) TagRepository {
+	return &GormTagRepository{db: db}
+}
+
+// Create stores a new tag owned by the given user
+func (r *GormTagRepository) Create(ctx context.Context, userID uint, tag *types.Tag) error {
+	tag.UserID = userID
+	return r.db.WithContext(ctx).Create(tag).Error
+}
+
+// List retrieves all tags owned by the given user
+func (r *GormTagRepository) List(ctx context.Context, userID uint) ([]types.Tag, error) {
+	var tags []types.Tag
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("name").Find(&tags).Error; err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// FindByID retrieves one of this user's tags by ID
+func (r *GormTagRepository) FindByID(ctx context.Context, userID uint, id uint) (*types.Tag, error) {
+	var tag types.Tag
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&tag, id).Error; err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// Delete removes one of this user's tags permanently, along with every
+// contact_tags association that referenced it.
+func (r *GormTagRepository) Delete(ctx context.Context, userID uint, id uint) error {
+	tag, err := r.FindByID(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM contact_tags WHERE tag_id = ?", tag.ID).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&types.Tag{}, tag.ID).Error
+	})
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+
+	"contactmanagement/internal/types"
+
+	"gorm.io/gorm"
+)
+
+// UserRepository defines the interface for user account persistence operations
+type UserRepository interface {
+	Create(ctx context.Context, user *types.User) error
+	FindByEmail(ctx context.Context, email string) (*types.User, error)
+	FindByID(ctx context.Context, id uint) (*types.User, error)
+}
+
+// GormUserRepository implements UserRepository using GORM
+type GormUserRepository struct {
+	db *gorm.DB
+}
+
+// NewUserRepository creates a new user repository
+func NewUserRepository(db *gorm.DB) UserRepository {
+	return &GormUserRepository{db: db}
+}
+
+// Create stores a new user account
+func (r *GormUserRepository) Create(ctx context.Context, user *types.User) error {
+	return r.db.WithContext(ctx).Create(user).Error
+}
+
+// FindByEmail finds a user by email address
+func (r *GormUserRepository) FindByEmail(ctx context.Context, email string) (*types.User, error) {
+	var user types.User
+	if err := r.db.WithContext(ctx).Where("email = ?", email).First(&user).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// FindByID retrieves a user by ID
+func (r *GormUserRepository) FindByID(ctx context.Context, id uint) (*types.User, error) {
+	var user types.User
+	if err := r.db.WithContext(ctx).First(&user, id).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
@@ -5,28 +5,66 @@ import "gorm.io/gorm"
 // Contact represents a contact in the system
 type Contact struct {
 	gorm.Model
-	FirstName       string `json:"firstName" gorm:"not null"`
-	LastName        string `json:"lastName" gorm:"not null"`
-	Email           string `json:"email" gorm:"unique;not null"`
-	PrimaryPhone    string `json:"primaryPhone" gorm:"not null"`
+	UserID           uint    `json:"userId" gorm:"not null;index;uniqueIndex:idx_contacts_user_email"`
+	FirstName        string  `json:"firstName" gorm:"not null"`
+	LastName         string  `json:"lastName" gorm:"not null"`
+	Email            string  `json:"email" gorm:"not null;uniqueIndex:idx_contacts_user_email"` // unique per owning user
+	PrimaryPhone     string  `json:"primaryPhone" gorm:"not null"`                              // canonical E.164
+	CountryCode      string  `json:"countryCode" gorm:"column:country_code"`
+	NationalNumber   string  `json:"nationalNumber" gorm:"column:national_number"`
 	AdditionalPhones []Phone `json:"additionalPhones,omitempty" gorm:"foreignKey:ContactID"`
+	Tags             []Tag   `json:"tags,omitempty" gorm:"many2many:contact_tags;"`
 }
 
 // Phone represents a phone number associated with a contact
 type Phone struct {
 	gorm.Model
-	ContactID uint   `json:"contactId"`
-	Number    string `json:"number"`
-	Type      string `json:"type"` // e.g., "home", "work", "mobile"
+	ContactID      uint   `json:"contactId"`
+	Number         string `json:"number"` // canonical E.164
+	Type           string `json:"type"`   // e.g., "home", "work", "mobile"
+	CountryCode    string `json:"countryCode" gorm:"column:country_code"`
+	NationalNumber string `json:"nationalNumber" gorm:"column:national_number"`
 }
 
 // CreateContactRequest represents the request body for creating a contact
 type CreateContactRequest struct {
-	FirstName        string   `json:"firstName" binding:"required"`
-	LastName         string   `json:"lastName" binding:"required"`
-	Email            string   `json:"email" binding:"required,email"`
-	PrimaryPhone     string   `json:"primaryPhone" binding:"required"`
-	AdditionalPhones []Phone  `json:"additionalPhones"`
+	FirstName        string  `json:"firstName" binding:"required"`
+	LastName         string  `json:"lastName" binding:"required"`
+	Email            string  `json:"email" binding:"required,email"`
+	PrimaryPhone     string  `json:"primaryPhone" binding:"required"`
+	AdditionalPhones []Phone `json:"additionalPhones"`
+}
+
+// JSONContact represents a contact in the JSON import/export format. It
+// deliberately excludes ID, UserID, and the gorm.Model timestamps so a
+// round-tripped export can be re-imported without colliding with the
+// original row (or, on Postgres, desynchronizing the id sequence).
+type JSONContact struct {
+	FirstName        string      `json:"firstName"`
+	LastName         string      `json:"lastName"`
+	Email            string      `json:"email"`
+	PrimaryPhone     string      `json:"primaryPhone"`
+	AdditionalPhones []JSONPhone `json:"additionalPhones,omitempty"`
+}
+
+// JSONPhone is the additional-phone shape nested inside JSONContact.
+type JSONPhone struct {
+	Number string `json:"number"`
+	Type   string `json:"type"`
+}
+
+// ImportResult summarizes the outcome of a bulk contact import.
+type ImportResult struct {
+	Imported int              `json:"imported"`
+	Failed   int              `json:"failed"`
+	Errors   []ImportRowError `json:"errors"`
+}
+
+// ImportRowError describes why a single row of an import failed.
+type ImportRowError struct {
+	Row     int    `json:"row"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
 }
 
 // CSVContact represents a contact in CSV format
@@ -35,4 +73,4 @@ type CSVContact struct {
 	LastName     string `csv:"Last Name"`
 	Email        string `csv:"Email Address"`
 	PrimaryPhone string `csv:"Primary Phone Number"`
-} 
\ No newline at end of file
+}
@@ -0,0 +1,17 @@
+package types
+
+import "gorm.io/gorm"
+
+// Tag is a label a user can attach to any number of their own contacts.
+type Tag struct {
+	gorm.Model
+	UserID uint   `json:"userId" gorm:"not null;index;uniqueIndex:idx_tags_user_name"`
+	Name   string `json:"name" gorm:"not null;uniqueIndex:idx_tags_user_name"`
+	Color  string `json:"color"`
+}
+
+// CreateTagRequest is the request body for POST /api/tags
+type CreateTagRequest struct {
+	Name  string `json:"name" binding:"required"`
+	Color string `json:"color"`
+}
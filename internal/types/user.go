@@ -0,0 +1,33 @@
+package types
+
+import "gorm.io/gorm"
+
+// User represents an account that owns a set of contacts.
+type User struct {
+	gorm.Model
+	Email        string `json:"email" gorm:"unique;not null"`
+	PasswordHash string `json:"-" gorm:"not null"`
+}
+
+// RegisterRequest is the request body for POST /api/auth/register
+type RegisterRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required,min=8"`
+}
+
+// LoginRequest is the request body for POST /api/auth/login
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required"`
+}
+
+// RefreshRequest is the request body for POST /api/auth/refresh
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken" binding:"required"`
+}
+
+// AuthResponse is returned by register, login, and refresh
+type AuthResponse struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}